@@ -0,0 +1,40 @@
+// Command jattachd runs the jattach capability as a long-lived gRPC service
+// over a UNIX socket, so a profiler or agent collocated with many JVMs can
+// multiplex attach operations instead of forking jattach per request.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/grafana/jattach/jvm/server"
+	"github.com/grafana/jattach/jvm/server/jattachpb"
+)
+
+func main() {
+	socketPath := flag.String("socket", "/var/run/jattachd.sock", "UNIX socket to listen on")
+	flag.Parse()
+
+	logger := slog.With("component", "jattachd")
+
+	os.Remove(*socketPath)
+	lis, err := net.Listen("unix", *socketPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to listen on %s: %v\n", *socketPath, err)
+		os.Exit(1)
+	}
+
+	grpcServer := grpc.NewServer()
+	jattachpb.RegisterJattachServiceServer(grpcServer, server.NewServer(logger))
+
+	logger.Info("listening", "socket", *socketPath)
+	if err := grpcServer.Serve(lis); err != nil {
+		logger.Error("server stopped", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,35 @@
+package jvm
+
+import (
+	"runtime"
+	"sync"
+)
+
+// attachMu serializes the namespace-switch/credential-switch/attach sequence
+// across the whole process. Go routes Setegid/Seteuid through the
+// all-threads syscall precisely so every OS thread's credentials stay in
+// sync, which means two attaches running concurrently against JVMs owned by
+// different users can't simply each hold their own euid/egid: whichever
+// Setegid/Seteuid call lands last wins process-wide, including for a thread
+// that is mid-handshake believing it still runs as the other target's user.
+// Holding attachMu for the full ResolveAttachTarget-through-attach sequence
+// keeps exactly one target's namespaces and credentials in effect at a time.
+var attachMu sync.Mutex
+
+// WithAttachLock runs fn serialized against every other attach in the
+// process, with the calling goroutine locked to its current OS thread. It deliberately never
+// calls runtime.UnlockOSThread: once fn returns and the calling goroutine
+// exits, Go retires the underlying OS thread instead of returning it to the
+// scheduler's pool, so a thread whose namespaces or credentials were altered
+// for this attach can never be handed to some unrelated later goroutine that
+// still believes it runs with the process's original identity. Callers
+// should invoke ResolveAttachTarget and the attach that follows it from
+// within the same fn, so both run back-to-back on the one pinned thread
+// with no other goroutine's attach interleaved.
+func WithAttachLock(fn func()) {
+	attachMu.Lock()
+	defer attachMu.Unlock()
+
+	runtime.LockOSThread()
+	fn()
+}
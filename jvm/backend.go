@@ -0,0 +1,63 @@
+package jvm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// AttachBackend implements the wire protocol used to reach a JVM's dynamic
+// attach listener. HotSpot and OpenJ9 speak entirely different protocols
+// (a per-PID UNIX socket vs. a shared advertisement directory plus a TCP
+// loopback connection), so each gets its own implementation.
+type AttachBackend interface {
+	// Attach drives the full handshake for this VM flavor and forwards the
+	// command's output to out, returning the JVM's response code.
+	Attach(pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int
+
+	// AttachToFile drives the same handshake but writes the command's
+	// output straight into dst. Implementations that can reach the JVM
+	// over a plain file descriptor should fast-path the copy with
+	// splice(2); others may fall back to buffering through Attach.
+	AttachToFile(pid, nspid, attachPid int, args []string, tmpPath string, dst *os.File, logger *slog.Logger) int
+
+	// AttachContext drives the same handshake as Attach but bounds every
+	// blocking step by ctx, aborting (and cleaning up anything it created)
+	// if ctx is done before the JVM responds.
+	AttachContext(ctx context.Context, pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int
+}
+
+// detectBackend figures out whether the target process is a HotSpot or an
+// OpenJ9/J9 VM. OpenJ9 VMs advertise themselves under a well-known directory
+// (under tmpPath) keyed by nspid, the PID as the JVM itself sees it; if that
+// is missing we fall back to sniffing the process command line for an
+// IBM/Eclipse J9 signature, and otherwise assume HotSpot. The cmdline sniff
+// reads /proc/<attachPid>/cmdline, a literal path in our own mount
+// namespace, so it needs attachPid rather than nspid.
+func detectBackend(nspid, attachPid int, tmpPath string) AttachBackend {
+	if _, err := os.Stat(fmt.Sprintf("%s/%d", openj9AdvertisementDir(tmpPath), nspid)); err == nil {
+		return openj9Backend{}
+	}
+
+	if isOpenJ9Process(attachPid) {
+		return openj9Backend{}
+	}
+
+	return hotspotBackend{}
+}
+
+// isOpenJ9Process reads /proc/<pid>/cmdline looking for the launcher or
+// vendor strings that IBM/Eclipse J9 builds carry.
+func isOpenJ9Process(pid int) bool {
+	raw, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return false
+	}
+
+	cmdline := strings.ToLower(strings.ReplaceAll(string(raw), "\x00", " "))
+	return strings.Contains(cmdline, "openj9") ||
+		strings.Contains(cmdline, "com.ibm") ||
+		strings.Contains(cmdline, "eclipse openj9")
+}
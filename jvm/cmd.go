@@ -1,25 +1,16 @@
 package jvm
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
-	"strconv"
-	"strings"
 	"syscall"
-	"time"
 
 	"github.com/grafana/jattach/util"
 )
 
-// Check if remote JVM has already opened socket for Dynamic Attach
-func checkSocket(pid int, tmpPath string) bool {
-	path := fmt.Sprintf("%s/.java_pid%d", tmpPath, pid)
-	info, err := os.Stat(path)
-	return err == nil && (info.Mode()&os.ModeSocket != 0)
-}
-
 // Check if a file is owned by current user
 func getFileOwner(path string) (uid int) {
 	info, err := os.Stat(path)
@@ -30,146 +21,29 @@ func getFileOwner(path string) (uid int) {
 	return int(stat.Uid)
 }
 
-// Force remote JVM to start Attach listener.
-// HotSpot will start Attach listener in response to SIGQUIT if it sees .attach_pid file
-func startAttachMechanism(pid, nspid, attachPid int, tmpPath string) bool {
-	path := fmt.Sprintf("/proc/%d/cwd/.attach_pid%d", attachPid, nspid)
-	fd, err := os.Create(path)
-	if err != nil || (fd.Close() == nil && getFileOwner(path) != os.Geteuid()) {
-		os.Remove(path)
-		path = fmt.Sprintf("%s/.attach_pid%d", tmpPath, nspid)
-		fd, err = os.Create(path)
-		if err != nil {
-			return false
-		}
-		fd.Close()
-	}
-
-	syscall.Kill(pid, syscall.SIGQUIT)
-
-	ts := 20 * time.Millisecond
-	for i := 0; i < 300; i++ {
-		time.Sleep(ts)
-		if checkSocket(nspid, tmpPath) {
-			os.Remove(path)
-			return true
-		}
-		ts += 20 * time.Millisecond
-	}
-
-	os.Remove(path)
-	return false
-}
-
-// Connect to UNIX domain socket created by JVM for Dynamic Attach
-func connectSocket(pid int, tmpPath string) (int, error) {
-	addr := &syscall.SockaddrUnix{Name: fmt.Sprintf("%s/.java_pid%d", tmpPath, pid)}
-	fd, err := syscall.Socket(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
-	if err != nil {
-		return -1, err
-	}
-	if err := syscall.Connect(fd, addr); err != nil {
-		syscall.Close(fd)
-		return -1, err
-	}
-	return fd, nil
-}
-
-// Send command with arguments to socket
-func writeCommand(fd int, args []string) error {
-	request := make([]byte, 0)
-
-	request = append(request, byte('1'))
-	request = append(request, byte(0))
-
-	for i := 0; i < 4; i++ {
-		if i < len(args) {
-			request = append(request, []byte(args[i])...)
-		}
-		request = append(request, byte(0))
-	}
-
-	_, err := syscall.Write(fd, request)
-	return err
-}
-
-// Mirror response from remote JVM to stdout
-func readResponse(fd int, args []string, out chan []byte, logger *slog.Logger) int {
-	buf := make([]byte, 8192)
-	n, err := syscall.Read(fd, buf)
-	if err != nil {
-		logger.Error("error reading response from JVM", "error", err)
-		return 1
-	}
-	if n == 0 {
-		logger.Error("unexpected EOF while reading response from the JVM")
-		return 1
-	}
-
-	buf = buf[:n]
-	result, _ := strconv.Atoi(string(buf[:n]))
-
-	if len(args) > 0 && args[0] == "load" {
-		total := n
-		for total < len(buf)-1 {
-			n, err = syscall.Read(fd, buf[total:])
-			if err != nil || n == 0 {
-				break
-			}
-			total += n
-		}
-		buf = buf[:total]
-
-		if result == 0 && len(buf) >= 2 {
-			if strings.HasPrefix(string(buf[2:]), "return code: ") {
-				result, _ = strconv.Atoi(string(buf[15:]))
-			} else if (buf[2] >= '0' && buf[2] <= '9') || buf[2] == '-' {
-				result, _ = strconv.Atoi(string(buf[2:]))
-			} else {
-				result = -1
-			}
-		}
-	}
-
-	logger.Info("JVM response", "code", result)
-
-	for {
-		n, err := syscall.Read(fd, buf)
-		if n == 0 || err != nil {
-			break
-		}
-		out <- buf[:n]
-	}
-
-	out <- []byte(fmt.Sprintln())
-
-	return result
-}
-
-func jattachHotspot(pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
-	if !checkSocket(nspid, tmpPath) && !startAttachMechanism(pid, nspid, attachPid, tmpPath) {
-		logger.Error("could not start the attach mechanism")
-		return 1
-	}
-
-	fd, err := connectSocket(nspid, tmpPath)
-	if err != nil {
-		logger.Error("could not connect to JVM socket", "error", err)
-		return 1
-	}
-	defer syscall.Close(fd)
-
-	logger.Info("connected to the JVM")
-
-	if err := writeCommand(fd, args); err != nil {
-		logger.Error("error writing to the JVM socket", "error", err)
-		return 1
-	}
-
-	return readResponse(fd, args, out, logger)
+// AttachTarget is everything an attach backend needs to reach a JVM once
+// its namespaces and credentials have been resolved: the PID as seen from
+// inside its own namespace, the PID to use for filesystem paths reachable
+// from our mount namespace, and the tmp directory the JVM publishes its
+// attach socket/advertisement directory under.
+type AttachTarget struct {
+	NSPid     int
+	AttachPid int
+	TmpPath   string
 }
 
-func Jattach(pid int, argv []string, out chan []byte, logger *slog.Logger) int {
+// ResolveAttachTarget switches the calling goroutine's namespaces and
+// credentials to match pid and returns what the attach backends need to
+// reach it. It must be called again for every command against pid, even a
+// repeat: the namespace switch only applies to the OS thread the calling goroutine is
+// currently pinned to, and Go is free to move a goroutine (or hand a
+// concurrent call for the same pid) to a different one between calls. Its
+// result is not safe to cache and reuse via JattachTarget across separate
+// commands. Callers must invoke it, and the attach that follows, from
+// within WithAttachLock: Setegid/Seteuid below apply process-wide, so
+// running this concurrently with another target's resolve-and-attach races
+// on the whole process's identity.
+func ResolveAttachTarget(pid int, logger *slog.Logger) (AttachTarget, error) {
 	myUID := syscall.Geteuid()
 	myGID := syscall.Getegid()
 	targetUID := myUID
@@ -178,7 +52,7 @@ func Jattach(pid int, argv []string, out chan []byte, logger *slog.Logger) int {
 
 	if util.GetProcessInfo(pid, &targetUID, &targetGID, &nspid) != nil {
 		logger.Error("process not found", "pid", pid)
-		return 1
+		return AttachTarget{}, fmt.Errorf("process %d not found", pid)
 	}
 
 	// Container support: switch to the target namespaces.
@@ -192,7 +66,7 @@ func Jattach(pid int, argv []string, out chan []byte, logger *slog.Logger) int {
 	if (myGID != targetGID && syscall.Setegid(int(targetGID)) != nil) ||
 		(myUID != targetUID && syscall.Seteuid(int(targetUID)) != nil) {
 		logger.Error("failed to change credentials to match the target process")
-		return 1
+		return AttachTarget{}, fmt.Errorf("failed to change credentials to match pid %d", pid)
 	}
 
 	attachPid := pid
@@ -200,11 +74,96 @@ func Jattach(pid int, argv []string, out chan []byte, logger *slog.Logger) int {
 		attachPid = nspid
 	}
 
-	tmpPath := util.GetTmpPath(attachPid)
+	return AttachTarget{NSPid: nspid, AttachPid: attachPid, TmpPath: util.GetTmpPath(attachPid)}, nil
+}
 
+// JattachTarget runs a command against a JVM whose namespaces have already
+// been resolved via ResolveAttachTarget. Callers that resolved target
+// themselves (rather than going through Jattach) must still be inside the
+// same WithAttachLock call that did the resolving.
+func JattachTarget(pid int, target AttachTarget, argv []string, out chan []byte, logger *slog.Logger) int {
 	// Make write() return EPIPE instead of abnormal process termination
 	signal.Ignore(syscall.SIGPIPE)
 
 	defer close(out)
-	return jattachHotspot(pid, nspid, attachPid, argv, tmpPath, out, logger)
+	return detectBackend(target.NSPid, target.AttachPid, target.TmpPath).Attach(pid, target.NSPid, target.AttachPid, argv, target.TmpPath, out, logger)
+}
+
+func Jattach(pid int, argv []string, out chan []byte, logger *slog.Logger) int {
+	result := 1
+	WithAttachLock(func() {
+		target, err := ResolveAttachTarget(pid, logger)
+		if err != nil {
+			close(out)
+			return
+		}
+
+		result = JattachTarget(pid, target, argv, out, logger)
+	})
+	return result
+}
+
+// JattachToFile runs a command against pid and writes its output straight
+// into dst instead of a channel, so a multi-gigabyte dumpheap doesn't have
+// to pass through an 8 KiB user-space buffer and a channel send per chunk.
+// Backends that can reach the JVM over a plain socket fast-path this with
+// splice(2); others fall back to the buffered path internally.
+func JattachToFile(pid int, argv []string, dst *os.File, logger *slog.Logger) int {
+	result := 1
+	WithAttachLock(func() {
+		target, err := ResolveAttachTarget(pid, logger)
+		if err != nil {
+			return
+		}
+
+		// Make write() return EPIPE instead of abnormal process termination
+		signal.Ignore(syscall.SIGPIPE)
+
+		result = detectBackend(target.NSPid, target.AttachPid, target.TmpPath).AttachToFile(pid, target.NSPid, target.AttachPid, argv, target.TmpPath, dst, logger)
+	})
+	return result
+}
+
+// JattachContext runs a command against pid with every blocking step of the
+// attach flow bounded by ctx, so callers driving jattach from an HTTP
+// handler or scrape loop get bounded latency instead of the SIGQUIT wait of
+// up to about 15 minutes and the unbounded socket I/O that Jattach has.
+func JattachContext(ctx context.Context, pid int, argv []string, out chan []byte, logger *slog.Logger) int {
+	result := 1
+	WithAttachLock(func() {
+		if ctx.Err() != nil {
+			close(out)
+			logger.Error("attach aborted before it started", "error", ctx.Err())
+			return
+		}
+
+		target, err := ResolveAttachTarget(pid, logger)
+		if err != nil {
+			close(out)
+			return
+		}
+
+		result = JattachTargetContext(ctx, pid, target, argv, out, logger)
+	})
+	return result
+}
+
+// JattachTargetContext is JattachTarget with every blocking step of the
+// attach flow bounded by ctx, for callers (like the gRPC server) that
+// resolve a target once via ResolveAttachTarget and need the same
+// cancellation guarantee JattachContext gives Jattach. As with JattachTarget,
+// callers must invoke this from within the same WithAttachLock call that
+// resolved target.
+func JattachTargetContext(ctx context.Context, pid int, target AttachTarget, argv []string, out chan []byte, logger *slog.Logger) int {
+	// Make write() return EPIPE instead of abnormal process termination
+	signal.Ignore(syscall.SIGPIPE)
+
+	defer close(out)
+
+	if ctx.Err() != nil {
+		logger.Error("attach aborted before it started", "error", ctx.Err())
+		return 1
+	}
+
+	return detectBackend(target.NSPid, target.AttachPid, target.TmpPath).AttachContext(ctx, pid, target.NSPid, target.AttachPid, argv, target.TmpPath, out, logger)
 }
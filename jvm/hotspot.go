@@ -0,0 +1,377 @@
+package jvm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// hotspotBackend speaks the classic HotSpot dynamic attach protocol: a
+// per-PID UNIX domain socket that the JVM opens in response to SIGQUIT once
+// it sees a matching .attach_pid file.
+type hotspotBackend struct{}
+
+// Check if remote JVM has already opened socket for Dynamic Attach
+func checkSocket(pid int, tmpPath string) bool {
+	path := fmt.Sprintf("%s/.java_pid%d", tmpPath, pid)
+	info, err := os.Stat(path)
+	return err == nil && (info.Mode()&os.ModeSocket != 0)
+}
+
+// createAttachPidFile creates the .attach_pidN file HotSpot looks for when
+// it receives SIGQUIT, preferring the target's own cwd (so a setuid wrapper
+// the JVM trusts can see it) and falling back to tmpPath if that cwd isn't
+// writable by us or ends up owned by someone else.
+func createAttachPidFile(nspid, attachPid int, tmpPath string) (string, bool) {
+	path := fmt.Sprintf("/proc/%d/cwd/.attach_pid%d", attachPid, nspid)
+	fd, err := os.Create(path)
+	if err != nil || (fd.Close() == nil && getFileOwner(path) != os.Geteuid()) {
+		os.Remove(path)
+		path = fmt.Sprintf("%s/.attach_pid%d", tmpPath, nspid)
+		fd, err = os.Create(path)
+		if err != nil {
+			return "", false
+		}
+		fd.Close()
+	}
+	return path, true
+}
+
+// Force remote JVM to start Attach listener.
+// HotSpot will start Attach listener in response to SIGQUIT if it sees .attach_pid file
+func startAttachMechanism(pid, nspid, attachPid int, tmpPath string) bool {
+	path, ok := createAttachPidFile(nspid, attachPid, tmpPath)
+	if !ok {
+		return false
+	}
+
+	syscall.Kill(pid, syscall.SIGQUIT)
+
+	ts := 20 * time.Millisecond
+	for i := 0; i < 300; i++ {
+		time.Sleep(ts)
+		if checkSocket(nspid, tmpPath) {
+			os.Remove(path)
+			return true
+		}
+		ts += 20 * time.Millisecond
+	}
+
+	os.Remove(path)
+	return false
+}
+
+// startAttachMechanismContext is startAttachMechanism with the fixed
+// 300-iteration sleep loop replaced by a timer bounded by ctx, so a caller
+// with a deadline gets a bounded wait instead of jattach's usual up-to
+// several-minutes worst case.
+func startAttachMechanismContext(ctx context.Context, pid, nspid, attachPid int, tmpPath string) bool {
+	path, ok := createAttachPidFile(nspid, attachPid, tmpPath)
+	if !ok {
+		return false
+	}
+
+	syscall.Kill(pid, syscall.SIGQUIT)
+
+	ts := 20 * time.Millisecond
+	timer := time.NewTimer(ts)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.Remove(path)
+			return false
+		case <-timer.C:
+			if checkSocket(nspid, tmpPath) {
+				os.Remove(path)
+				return true
+			}
+			ts += 20 * time.Millisecond
+			timer.Reset(ts)
+		}
+	}
+}
+
+// setSocketDeadline applies ctx's deadline, if any, as SO_RCVTIMEO and
+// SO_SNDTIMEO on fd as an initial guard against a single Read/Write call
+// blocking past it. Because that socket option is a per-syscall timeout
+// rather than an absolute deadline, it alone can't bound a sequence of
+// several reads; AttachContext pairs it with a watcher that shuts the
+// socket down as soon as ctx is actually cancelled. Sockets without a
+// deadline are left with the kernel default (block indefinitely), matching
+// the non-context Attach path.
+func setSocketDeadline(fd int, ctx context.Context) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	tv := syscall.NsecToTimeval(remaining.Nanoseconds())
+	if err := syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &tv); err != nil {
+		return err
+	}
+	return syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_SNDTIMEO, &tv)
+}
+
+// Connect to UNIX domain socket created by JVM for Dynamic Attach
+func connectSocket(pid int, tmpPath string) (int, error) {
+	addr := &syscall.SockaddrUnix{Name: fmt.Sprintf("%s/.java_pid%d", tmpPath, pid)}
+	fd, err := syscall.Socket(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		return -1, err
+	}
+	if err := syscall.Connect(fd, addr); err != nil {
+		syscall.Close(fd)
+		return -1, err
+	}
+	return fd, nil
+}
+
+// Send command with arguments to socket
+func writeCommand(fd int, args []string) error {
+	request := make([]byte, 0)
+
+	request = append(request, byte('1'))
+	request = append(request, byte(0))
+
+	for i := 0; i < 4; i++ {
+		if i < len(args) {
+			request = append(request, []byte(args[i])...)
+		}
+		request = append(request, byte(0))
+	}
+
+	_, err := syscall.Write(fd, request)
+	return err
+}
+
+// parseStatusLine splits the small numeric status line the JVM sends ahead
+// of the body of a response from whatever body bytes landed in the same
+// read, past its terminating newline. readResponse and readStatusPrefix
+// both need this: the status line and the start of the body can arrive in
+// a single read, and the trailing bytes have to be recovered or they're
+// lost.
+func parseStatusLine(buf []byte) (result int, rest []byte) {
+	idx := bytes.IndexByte(buf, '\n')
+	if idx < 0 {
+		result, _ = strconv.Atoi(strings.TrimSpace(string(buf)))
+		return result, nil
+	}
+
+	if parsed, err := strconv.Atoi(strings.TrimSpace(string(buf[:idx]))); err == nil {
+		result = parsed
+	}
+	if idx+1 < len(buf) {
+		rest = buf[idx+1:]
+	}
+	return result, rest
+}
+
+// Mirror response from remote JVM to stdout
+func readResponse(fd int, args []string, out chan []byte, logger *slog.Logger) int {
+	buf := make([]byte, 8192)
+	n, err := syscall.Read(fd, buf)
+	if err != nil {
+		logger.Error("error reading response from JVM", "error", err)
+		return 1
+	}
+	if n == 0 {
+		logger.Error("unexpected EOF while reading response from the JVM")
+		return 1
+	}
+
+	buf = buf[:n]
+	result, _ := strconv.Atoi(string(buf[:n]))
+
+	if len(args) > 0 && args[0] == "load" {
+		total := n
+		for total < len(buf)-1 {
+			n, err = syscall.Read(fd, buf[total:])
+			if err != nil || n == 0 {
+				break
+			}
+			total += n
+		}
+		buf = buf[:total]
+
+		if result == 0 && len(buf) >= 2 {
+			if strings.HasPrefix(string(buf[2:]), "return code: ") {
+				result, _ = strconv.Atoi(string(buf[15:]))
+			} else if (buf[2] >= '0' && buf[2] <= '9') || buf[2] == '-' {
+				result, _ = strconv.Atoi(string(buf[2:]))
+			} else {
+				result = -1
+			}
+		}
+	} else {
+		var rest []byte
+		result, rest = parseStatusLine(buf)
+		if rest != nil {
+			out <- rest
+		}
+	}
+
+	logger.Info("JVM response", "code", result)
+
+	for {
+		n, err := syscall.Read(fd, buf)
+		if n == 0 || err != nil {
+			break
+		}
+		out <- buf[:n]
+	}
+
+	out <- []byte(fmt.Sprintln())
+
+	return result
+}
+
+func (hotspotBackend) Attach(pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
+	if !checkSocket(nspid, tmpPath) && !startAttachMechanism(pid, nspid, attachPid, tmpPath) {
+		logger.Error("could not start the attach mechanism")
+		return 1
+	}
+
+	fd, err := connectSocket(nspid, tmpPath)
+	if err != nil {
+		logger.Error("could not connect to JVM socket", "error", err)
+		return 1
+	}
+	defer syscall.Close(fd)
+
+	logger.Info("connected to the JVM")
+
+	if err := writeCommand(fd, args); err != nil {
+		logger.Error("error writing to the JVM socket", "error", err)
+		return 1
+	}
+
+	return readResponse(fd, args, out, logger)
+}
+
+func (hotspotBackend) AttachContext(ctx context.Context, pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
+	if ctx.Err() != nil {
+		logger.Error("attach aborted before it started", "error", ctx.Err())
+		return 1
+	}
+
+	if !checkSocket(nspid, tmpPath) && !startAttachMechanismContext(ctx, pid, nspid, attachPid, tmpPath) {
+		if ctx.Err() != nil {
+			logger.Error("attach mechanism aborted", "error", ctx.Err())
+		} else {
+			logger.Error("could not start the attach mechanism")
+		}
+		return 1
+	}
+
+	fd, err := connectSocket(nspid, tmpPath)
+	if err != nil {
+		logger.Error("could not connect to JVM socket", "error", err)
+		return 1
+	}
+	defer syscall.Close(fd)
+
+	if err := setSocketDeadline(fd, ctx); err != nil {
+		logger.Error("context deadline exceeded before talking to the JVM", "error", err)
+		return 1
+	}
+
+	// SO_RCVTIMEO/SO_SNDTIMEO only bound a single syscall, so a response
+	// trickling in across several reads could otherwise run well past
+	// ctx's deadline. Shut the socket down as soon as ctx is cancelled to
+	// unblock whichever Read or Write is in flight.
+	watchDone := make(chan struct{})
+	defer close(watchDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			syscall.Shutdown(fd, syscall.SHUT_RDWR)
+		case <-watchDone:
+		}
+	}()
+
+	logger.Info("connected to the JVM")
+
+	if err := writeCommand(fd, args); err != nil {
+		logger.Error("error writing to the JVM socket", "error", err)
+		return 1
+	}
+
+	return readResponse(fd, args, out, logger)
+}
+
+// readStatusPrefix reads the small numeric status line the JVM sends ahead
+// of the body of a response. Body bytes that arrived in the same read as
+// the status line, past its terminating newline, are returned in rest so
+// the caller can forward them before switching to a lower-level read path
+// (like a splice) that only sees bytes still on the wire.
+func readStatusPrefix(fd int, logger *slog.Logger) (result int, rest []byte, err error) {
+	buf := make([]byte, 8192)
+	n, err := syscall.Read(fd, buf)
+	if err != nil {
+		logger.Error("error reading response from JVM", "error", err)
+		return 0, nil, err
+	}
+	if n == 0 {
+		logger.Error("unexpected EOF while reading response from the JVM")
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	buf = buf[:n]
+	result, rest = parseStatusLine(buf)
+
+	logger.Info("JVM response", "code", result)
+	return result, rest, nil
+}
+
+func (hotspotBackend) AttachToFile(pid, nspid, attachPid int, args []string, tmpPath string, dst *os.File, logger *slog.Logger) int {
+	if !checkSocket(nspid, tmpPath) && !startAttachMechanism(pid, nspid, attachPid, tmpPath) {
+		logger.Error("could not start the attach mechanism")
+		return 1
+	}
+
+	fd, err := connectSocket(nspid, tmpPath)
+	if err != nil {
+		logger.Error("could not connect to JVM socket", "error", err)
+		return 1
+	}
+	defer syscall.Close(fd)
+
+	logger.Info("connected to the JVM")
+
+	if err := writeCommand(fd, args); err != nil {
+		logger.Error("error writing to the JVM socket", "error", err)
+		return 1
+	}
+
+	result, rest, err := readStatusPrefix(fd, logger)
+	if err != nil {
+		return 1
+	}
+
+	if len(rest) > 0 {
+		if _, err := dst.Write(rest); err != nil {
+			logger.Error("error writing buffered JVM response to file", "error", err)
+			return 1
+		}
+	}
+
+	if err := spliceToFile(fd, dst, logger); err != nil {
+		logger.Error("error streaming JVM response to file", "error", err)
+		return 1
+	}
+
+	return result
+}
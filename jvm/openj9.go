@@ -0,0 +1,352 @@
+package jvm
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// openj9AdvertisementDirName is the well-known directory name OpenJ9 VMs and
+// attachers use to find each other under tmpPath: one subdirectory per
+// participant, plus a shared _master lock file and _notifier SysV semaphore.
+const openj9AdvertisementDirName = ".com_ibm_tools_attach"
+
+// openj9AdvertisementDir resolves the advertisement directory under tmpPath,
+// the same namespace/TMPDIR-aware directory hotspotBackend resolves its
+// per-PID socket under: /tmp isn't always where the target JVM's mount
+// namespace has it mapped.
+func openj9AdvertisementDir(tmpPath string) string {
+	return filepath.Join(tmpPath, openj9AdvertisementDirName)
+}
+
+// openj9Backend speaks OpenJ9's attach protocol: participants advertise
+// themselves under openj9AdvertisementDir, coordinate through a shared file
+// lock and SysV semaphore, and then exchange commands over a loopback TCP
+// socket instead of HotSpot's per-PID UNIX domain socket.
+type openj9Backend struct{}
+
+// openj9AttachInfo is the subset of a target VM's attachInfo properties
+// file that we need to complete the handshake.
+type openj9AttachInfo struct {
+	vmID string
+	key  string
+	port int
+}
+
+// sembuf mirrors struct sembuf from <sys/sem.h> for the semop(2) syscall.
+type sembuf struct {
+	SemNum uint16
+	SemOp  int16
+	SemFlg int16
+}
+
+func (b openj9Backend) Attach(pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
+	return b.attach(context.Background(), pid, nspid, attachPid, args, tmpPath, out, logger)
+}
+
+// AttachContext is Attach with ctx wired through the loopback connection:
+// its deadline (if any) is applied to the socket, and a watcher closes the
+// connection as soon as ctx is done so a blocked read/write during the
+// handshake unblocks immediately. Cancellation during the local
+// coordination steps (advertisement directory, master lock, notifier
+// semaphore) is only checked between them; those steps talk to
+// flock(2)/semop(2), not a context-aware API, so cancellation there takes
+// effect at the next checkpoint rather than immediately.
+func (b openj9Backend) AttachContext(ctx context.Context, pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
+	return b.attach(ctx, pid, nspid, attachPid, args, tmpPath, out, logger)
+}
+
+func (openj9Backend) attach(ctx context.Context, pid, nspid, attachPid int, args []string, tmpPath string, out chan []byte, logger *slog.Logger) int {
+	if ctx.Err() != nil {
+		logger.Error("attach aborted before it started", "error", ctx.Err())
+		return 1
+	}
+
+	info, err := readOpenJ9AttachInfo(tmpPath, nspid)
+	if err != nil {
+		logger.Error("could not read OpenJ9 attach info", "error", err)
+		return 1
+	}
+
+	master, err := lockOpenJ9Master(tmpPath)
+	if err != nil {
+		logger.Error("could not lock the OpenJ9 attach master", "error", err)
+		return 1
+	}
+	defer unlockOpenJ9Master(master)
+
+	if ctx.Err() != nil {
+		logger.Error("attach aborted while holding the master lock", "error", ctx.Err())
+		return 1
+	}
+
+	attacherDir, err := advertiseOpenJ9Attacher(tmpPath)
+	if err != nil {
+		logger.Error("could not advertise as an OpenJ9 attacher", "error", err)
+		return 1
+	}
+	defer os.RemoveAll(attacherDir)
+
+	if err := postOpenJ9Notifier(tmpPath); err != nil {
+		logger.Error("could not notify the OpenJ9 attach listener", "error", err)
+		return 1
+	}
+
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", fmt.Sprintf("127.0.0.1:%d", info.port))
+	if err != nil {
+		logger.Error("could not connect to the OpenJ9 attach listener", "error", err)
+		return 1
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// SetDeadline only takes effect when ctx carries one; a plain
+	// context.WithCancel leaves the connection with no deadline at all, so
+	// a blocked readOpenJ9Line/readOpenJ9Response would never notice
+	// cancellation on its own. Close the connection as soon as ctx is done
+	// to unblock whichever read is in flight, mirroring hotspotBackend's
+	// AttachContext watcher.
+	watchDone := make(chan struct{})
+	defer close(watchDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-watchDone:
+		}
+	}()
+
+	logger.Info("connected to the JVM")
+
+	sourceID := strconv.Itoa(os.Getpid())
+	if err := writeOpenJ9Command(conn, fmt.Sprintf("ATTACH_CONNECTED %s %s", info.key, sourceID)); err != nil {
+		logger.Error("error writing to the JVM socket", "error", err)
+		return 1
+	}
+
+	reader := bufio.NewReader(conn)
+
+	ack, err := readOpenJ9Line(reader)
+	if err != nil {
+		logger.Error("error reading response from JVM", "error", err)
+		return 1
+	}
+	if !strings.HasPrefix(ack, "ATTACH_ACK") {
+		logger.Error("OpenJ9 attach handshake rejected", "response", ack)
+		return 1
+	}
+
+	if err := writeOpenJ9Command(conn, openj9LoadCommand(args)); err != nil {
+		logger.Error("error writing to the JVM socket", "error", err)
+		return 1
+	}
+
+	result := readOpenJ9Response(reader, out, logger)
+
+	writeOpenJ9Command(conn, "ATTACH_DETACH")
+
+	return result
+}
+
+// AttachToFile drives the same handshake as Attach, but copies the response
+// through the buffered channel path rather than splicing: the OpenJ9
+// response line is read through a bufio.Reader for line framing, so by the
+// time the body starts the reader may already hold buffered bytes that a
+// raw splice off the socket fd would silently skip.
+func (b openj9Backend) AttachToFile(pid, nspid, attachPid int, args []string, tmpPath string, dst *os.File, logger *slog.Logger) int {
+	out := make(chan []byte)
+	done := make(chan struct{})
+
+	go func() {
+		for chunk := range out {
+			dst.Write(chunk)
+		}
+		close(done)
+	}()
+
+	result := b.Attach(pid, nspid, attachPid, args, tmpPath, out, logger)
+	close(out)
+	<-done
+	return result
+}
+
+// readOpenJ9AttachInfo locates the target VM's advertisement subdirectory
+// and parses its attachInfo properties file for the loopback port and
+// secret key needed to connect.
+func readOpenJ9AttachInfo(tmpPath string, pid int) (openj9AttachInfo, error) {
+	path := filepath.Join(openj9AdvertisementDir(tmpPath), strconv.Itoa(pid), "attachInfo")
+	f, err := os.Open(path)
+	if err != nil {
+		return openj9AttachInfo{}, err
+	}
+	defer f.Close()
+
+	info := openj9AttachInfo{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "vmId":
+			info.vmID = value
+		case "key":
+			info.key = value
+		case "port":
+			info.port, _ = strconv.Atoi(value)
+		}
+	}
+
+	if info.port == 0 {
+		return openj9AttachInfo{}, fmt.Errorf("no port advertised in %s", path)
+	}
+	return info, scanner.Err()
+}
+
+// lockOpenJ9Master takes the shared file lock that serializes access to the
+// advertisement directory while we add our own subdirectory and poke the
+// notifier semaphore.
+func lockOpenJ9Master(tmpPath string) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(openj9AdvertisementDir(tmpPath), "_master"), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func unlockOpenJ9Master(f *os.File) {
+	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	f.Close()
+}
+
+// advertiseOpenJ9Attacher creates our own subdirectory under the
+// advertisement directory, the way the OpenJ9 protocol expects every
+// participant (attacher or attachable VM) to announce itself.
+func advertiseOpenJ9Attacher(tmpPath string) (string, error) {
+	dir := filepath.Join(openj9AdvertisementDir(tmpPath), strconv.Itoa(os.Getpid()))
+	if err := os.Mkdir(dir, 0700); err != nil && !os.IsExist(err) {
+		return "", err
+	}
+
+	contents := fmt.Sprintf("vmId=%d\nprocessId=%d\nuserUid=%d\n", os.Getpid(), os.Getpid(), os.Getuid())
+	if err := os.WriteFile(filepath.Join(dir, "attachInfo"), []byte(contents), 0600); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+// postOpenJ9Notifier increments the SysV semaphore that every OpenJ9 VM
+// waits on, waking them up to re-scan the advertisement directory for new
+// attachers.
+func postOpenJ9Notifier(tmpPath string) error {
+	key, err := ftok(filepath.Join(openj9AdvertisementDir(tmpPath), "_notifier"), 0xa1)
+	if err != nil {
+		return err
+	}
+
+	semid, _, errno := syscall.Syscall(syscall.SYS_SEMGET, uintptr(key), 1, 0)
+	if errno != 0 {
+		return errno
+	}
+
+	op := sembuf{SemNum: 0, SemOp: 1, SemFlg: 0}
+	if _, _, errno := syscall.Syscall(syscall.SYS_SEMOP, semid, uintptr(unsafe.Pointer(&op)), 1); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// ftok reproduces the libc ftok(3) key derivation so we land on the same
+// SysV semaphore key the target JVM used when it created _notifier.
+func ftok(path string, projID byte) (int32, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	stat := info.Sys().(*syscall.Stat_t)
+	return int32(uint32(projID)<<24 | (uint32(stat.Dev)&0xff)<<16 | (uint32(stat.Ino) & 0xffff)), nil
+}
+
+// openj9LoadCommand translates jattach's argv into the ATTACH_LOADAGENT
+// request OpenJ9 expects.
+func openj9LoadCommand(args []string) string {
+	if len(args) == 0 {
+		return "ATTACH_LOADAGENT(jcmd,)"
+	}
+	if args[0] == "load" && len(args) >= 2 {
+		agent := args[1]
+		if len(args) > 2 {
+			agent += "=" + strings.Join(args[2:], " ")
+		}
+		return fmt.Sprintf("ATTACH_LOADAGENT(instrument,%s)", agent)
+	}
+	return fmt.Sprintf("ATTACH_LOADAGENT(jcmd,%s)", strings.Join(args, " "))
+}
+
+func writeOpenJ9Command(conn net.Conn, cmd string) error {
+	_, err := conn.Write(append([]byte(cmd), 0))
+	return err
+}
+
+func readOpenJ9Line(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+// readOpenJ9Response streams an ATTACH_ACK/ATTACH_ERR reply to out, its
+// status line first and then the body, and returns 0 when the status line
+// starts with ATTACH_ACK and 1 otherwise. reader must be the same
+// bufio.Reader used to read the preceding ATTACH_ACK line, so that any body
+// bytes it already buffered from the socket are not lost.
+func readOpenJ9Response(reader *bufio.Reader, out chan []byte, logger *slog.Logger) int {
+	status, err := reader.ReadString('\n')
+	if err != nil {
+		logger.Error("error reading response from JVM", "error", err)
+		return 1
+	}
+	status = strings.TrimRight(status, "\r\n")
+
+	result := 0
+	if !strings.HasPrefix(status, "ATTACH_ACK") {
+		result = 1
+	}
+	logger.Info("JVM response", "code", result)
+
+	out <- []byte(status)
+	out <- []byte(fmt.Sprintln())
+
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			out <- []byte(line)
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/grafana/jattach/jvm/server/jattachpb"
+)
+
+// Client wraps the generated gRPC client stub with the small amount of
+// connection setup jattachd's callers otherwise have to repeat: dialing a
+// UNIX socket and collecting a streamed response into a single buffer.
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  jattachpb.JattachServiceClient
+}
+
+// Dial connects to a jattachd instance listening on the given UNIX socket
+// path, e.g. "/var/run/jattachd.sock".
+func Dial(socketPath string) (*Client, error) {
+	conn, err := grpc.NewClient("unix:"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, rpc: jattachpb.NewJattachServiceClient(conn)}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) Attach(ctx context.Context, pid int32) (*jattachpb.AttachResponse, error) {
+	return c.rpc.Attach(ctx, &jattachpb.AttachRequest{Pid: pid})
+}
+
+func (c *Client) Load(ctx context.Context, pid int32, agent, options string) (*jattachpb.LoadResponse, error) {
+	return c.rpc.Load(ctx, &jattachpb.LoadRequest{Pid: pid, Agent: agent, Options: options})
+}
+
+func (c *Client) Properties(ctx context.Context, pid int32) (*jattachpb.PropertiesResponse, error) {
+	return c.rpc.Properties(ctx, &jattachpb.PidRequest{Pid: pid})
+}
+
+func (c *Client) SetFlag(ctx context.Context, pid int32, name, value string) (*jattachpb.JCmdStatus, error) {
+	return c.rpc.SetFlag(ctx, &jattachpb.SetFlagRequest{Pid: pid, Name: name, Value: value})
+}
+
+func (c *Client) PrintFlag(ctx context.Context, pid int32, name string) (*jattachpb.JCmdStatus, error) {
+	return c.rpc.PrintFlag(ctx, &jattachpb.PrintFlagRequest{Pid: pid, Name: name})
+}
+
+// ThreadDump collects the full streamed thread dump into a single buffer.
+func (c *Client) ThreadDump(ctx context.Context, pid int32) ([]byte, error) {
+	stream, err := c.rpc.ThreadDump(ctx, &jattachpb.ThreadDumpRequest{Pid: pid})
+	if err != nil {
+		return nil, err
+	}
+	return collect(stream)
+}
+
+// DumpHeap collects the full streamed heap dump into a single buffer. For
+// multi-gigabyte heaps, callers that care about memory pressure should use
+// the generated stream directly instead.
+func (c *Client) DumpHeap(ctx context.Context, pid int32, path string, live bool) ([]byte, error) {
+	stream, err := c.rpc.DumpHeap(ctx, &jattachpb.DumpHeapRequest{Pid: pid, Path: path, Live: live})
+	if err != nil {
+		return nil, err
+	}
+	return collect(stream)
+}
+
+// JCmd collects the full streamed jcmd output into a single buffer.
+func (c *Client) JCmd(ctx context.Context, pid int32, command string) ([]byte, error) {
+	stream, err := c.rpc.JCmd(ctx, &jattachpb.JCmdRequest{Pid: pid, Command: command})
+	if err != nil {
+		return nil, err
+	}
+	return collect(stream)
+}
+
+// InspectHeap collects the full streamed heap histogram into a single
+// buffer.
+func (c *Client) InspectHeap(ctx context.Context, pid int32) ([]byte, error) {
+	stream, err := c.rpc.InspectHeap(ctx, &jattachpb.PidRequest{Pid: pid})
+	if err != nil {
+		return nil, err
+	}
+	return collect(stream)
+}
+
+type dataChunkReceiver interface {
+	Recv() (*jattachpb.DataChunk, error)
+}
+
+func collect(stream dataChunkReceiver) ([]byte, error) {
+	var buf []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return buf, nil
+		}
+		if err != nil {
+			return buf, err
+		}
+		buf = append(buf, chunk.Data...)
+	}
+}
@@ -0,0 +1,5 @@
+// Package jattachpb holds the generated gRPC client/server code for
+// JattachService. Regenerate after editing jattach.proto:
+//
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative -I ../ ../jattach.proto
+package jattachpb
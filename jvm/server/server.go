@@ -0,0 +1,242 @@
+// Package server exposes the jvm package's attach capability over gRPC, so
+// a single process collocated with many JVMs can multiplex attach
+// operations instead of forking jattach once per request.
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/grafana/jattach/jvm"
+	"github.com/grafana/jattach/jvm/server/jattachpb"
+)
+
+// Server implements jattachpb.JattachServiceServer on top of the jvm
+// package.
+type Server struct {
+	logger *slog.Logger
+}
+
+// NewServer builds a Server ready to be registered on a grpc.Server via
+// jattachpb.RegisterJattachServiceServer.
+func NewServer(logger *slog.Logger) *Server {
+	return &Server{logger: logger}
+}
+
+// target resolves pid's namespaces and credentials for the calling
+// goroutine. This can't be cached across RPCs: ResolveAttachTarget doesn't
+// just compute a value, it switches the current OS thread into pid's
+// namespaces and euid/egid, and gRPC is free to schedule each RPC's
+// handler goroutine onto a different OS thread. Skipping it on a cache hit
+// would run the attach against whichever namespace/uid the handling
+// goroutine already happened to be in. Callers must invoke it from within
+// jvm.WithAttachLock, same as every other caller of ResolveAttachTarget.
+func (s *Server) target(pid int) (jvm.AttachTarget, error) {
+	return jvm.ResolveAttachTarget(pid, s.logger)
+}
+
+// run executes a command against pid and buffers its output, for RPCs that
+// return a single response message rather than a stream. It bounds the
+// attach by ctx so a client-side deadline or cancellation actually aborts
+// the in-flight handshake instead of leaving the handler goroutine blocked
+// on a stuck SIGQUIT wait or hung socket read. The whole target-then-attach
+// sequence runs inside jvm.WithAttachLock so concurrent RPCs against JVMs
+// owned by different users never contend over the process's single euid/egid.
+func (s *Server) run(ctx context.Context, pid int, argv []string) (int, []byte, error) {
+	var result int
+	var buf bytes.Buffer
+	var targetErr error
+
+	jvm.WithAttachLock(func() {
+		t, err := s.target(pid)
+		if err != nil {
+			targetErr = err
+			return
+		}
+
+		out := make(chan []byte)
+		done := make(chan struct{})
+		go func() {
+			for chunk := range out {
+				buf.Write(chunk)
+			}
+			close(done)
+		}()
+
+		result = jvm.JattachTargetContext(ctx, pid, t, argv, out, s.logger)
+		<-done
+	})
+
+	if targetErr != nil {
+		return 0, nil, targetErr
+	}
+	return result, buf.Bytes(), nil
+}
+
+// stream executes a command against pid and forwards its output to send as
+// it arrives, for RPCs that stream their response. Like run, it bounds the
+// attach by ctx so the streaming handler unblocks as soon as the client
+// cancels or the stream's deadline expires, and runs the whole
+// target-then-attach sequence inside jvm.WithAttachLock.
+func (s *Server) stream(ctx context.Context, pid int, argv []string, send func([]byte) error) (int, error) {
+	var result int
+	var sendErr error
+	var targetErr error
+
+	jvm.WithAttachLock(func() {
+		t, err := s.target(pid)
+		if err != nil {
+			targetErr = err
+			return
+		}
+
+		out := make(chan []byte)
+		done := make(chan struct{})
+		go func() {
+			for chunk := range out {
+				if sendErr == nil {
+					sendErr = send(chunk)
+				}
+			}
+			close(done)
+		}()
+
+		result = jvm.JattachTargetContext(ctx, pid, t, argv, out, s.logger)
+		<-done
+	})
+
+	if targetErr != nil {
+		return 0, targetErr
+	}
+	return result, sendErr
+}
+
+// statusErr surfaces a non-zero JVM response code as a typed gRPC status
+// instead of forcing every caller to re-parse an ad hoc integer.
+func statusErr(result int, body []byte) error {
+	if result == 0 {
+		return nil
+	}
+	return status.Errorf(codes.Internal, "jvm returned status %d: %s", result, bytes.TrimSpace(body))
+}
+
+func (s *Server) Attach(ctx context.Context, req *jattachpb.AttachRequest) (*jattachpb.AttachResponse, error) {
+	var targetErr error
+	jvm.WithAttachLock(func() {
+		_, targetErr = s.target(int(req.Pid))
+	})
+	if targetErr != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", targetErr)
+	}
+	return &jattachpb.AttachResponse{Status: 0}, nil
+}
+
+func (s *Server) Load(ctx context.Context, req *jattachpb.LoadRequest) (*jattachpb.LoadResponse, error) {
+	argv := []string{"load", req.Agent}
+	if req.Options != "" {
+		argv = append(argv, req.Options)
+	}
+
+	result, body, err := s.run(ctx, int(req.Pid), argv)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	if err := statusErr(result, body); err != nil {
+		return nil, err
+	}
+	return &jattachpb.LoadResponse{ReturnCode: int32(result)}, nil
+}
+
+func (s *Server) ThreadDump(req *jattachpb.ThreadDumpRequest, stream jattachpb.JattachService_ThreadDumpServer) error {
+	return s.runStream(int(req.Pid), []string{"threaddump"}, stream)
+}
+
+func (s *Server) DumpHeap(req *jattachpb.DumpHeapRequest, stream jattachpb.JattachService_DumpHeapServer) error {
+	argv := []string{"dumpheap"}
+	if req.Live {
+		argv = append(argv, "-live")
+	}
+	if req.Path != "" {
+		argv = append(argv, req.Path)
+	}
+	return s.runStream(int(req.Pid), argv, stream)
+}
+
+func (s *Server) JCmd(req *jattachpb.JCmdRequest, stream jattachpb.JattachService_JCmdServer) error {
+	argv := append([]string{"jcmd"}, strings.Fields(req.Command)...)
+	return s.runStream(int(req.Pid), argv, stream)
+}
+
+func (s *Server) InspectHeap(req *jattachpb.PidRequest, stream jattachpb.JattachService_InspectHeapServer) error {
+	return s.runStream(int(req.Pid), []string{"inspectheap"}, stream)
+}
+
+func (s *Server) Properties(ctx context.Context, req *jattachpb.PidRequest) (*jattachpb.PropertiesResponse, error) {
+	result, body, err := s.run(ctx, int(req.Pid), []string{"properties"})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	if err := statusErr(result, body); err != nil {
+		return nil, err
+	}
+	return &jattachpb.PropertiesResponse{Status: int32(result), Properties: parseProperties(body)}, nil
+}
+
+func (s *Server) SetFlag(ctx context.Context, req *jattachpb.SetFlagRequest) (*jattachpb.JCmdStatus, error) {
+	result, body, err := s.run(ctx, int(req.Pid), []string{"setflag", req.Name, req.Value})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return &jattachpb.JCmdStatus{Status: int32(result), Response: string(body)}, nil
+}
+
+func (s *Server) PrintFlag(ctx context.Context, req *jattachpb.PrintFlagRequest) (*jattachpb.JCmdStatus, error) {
+	result, body, err := s.run(ctx, int(req.Pid), []string{"printflag", req.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", err)
+	}
+	return &jattachpb.JCmdStatus{Status: int32(result), Response: string(body)}, nil
+}
+
+// dataChunkStream is the common shape of the four server-streaming
+// handlers; it lets runStream forward chunks without duplicating the
+// per-RPC plumbing. grpc.ServerStream (embedded by every generated stream
+// interface) supplies Context().
+type dataChunkStream interface {
+	Context() context.Context
+	Send(*jattachpb.DataChunk) error
+}
+
+func (s *Server) runStream(pid int, argv []string, stream dataChunkStream) error {
+	result, err := s.stream(stream.Context(), pid, argv, func(b []byte) error {
+		return stream.Send(&jattachpb.DataChunk{Data: b})
+	})
+	if err != nil {
+		return err
+	}
+	if result != 0 {
+		return status.Errorf(codes.Internal, "jvm returned status %d", result)
+	}
+	return nil
+}
+
+// parseProperties turns the "key=value" lines jattach's properties command
+// prints into a map, skipping anything that does not parse cleanly.
+func parseProperties(body []byte) map[string]string {
+	props := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return props
+}
@@ -0,0 +1,70 @@
+package jvm
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"syscall"
+	"time"
+)
+
+// splicePipeSize caps how much of the JVM's response we move through the
+// intermediate pipe per splice(2) call.
+const splicePipeSize = 1 << 20 // 1 MiB
+
+// splice(2)'s flags, like semget/semop's in openj9.go, have no wrapper in
+// package syscall; spliceSyscall below issues the raw syscall via
+// syscall.SYS_SPLICE instead.
+const (
+	spliceFMove     = 1 // SPLICE_F_MOVE
+	spliceFNonblock = 2 // SPLICE_F_NONBLOCK
+)
+
+// spliceToFile moves bytes from srcFd to dst entirely in kernel space via
+// an intermediate pipe, avoiding the user-space copy readResponse pays for
+// on every chunk. It returns once srcFd reaches EOF.
+func spliceToFile(srcFd int, dst *os.File, logger *slog.Logger) error {
+	var pipeFds [2]int
+	if err := syscall.Pipe(pipeFds[:]); err != nil {
+		return fmt.Errorf("creating splice pipe: %w", err)
+	}
+	defer syscall.Close(pipeFds[0])
+	defer syscall.Close(pipeFds[1])
+
+	dstFd := int(dst.Fd())
+
+	for {
+		n, err := spliceSyscall(srcFd, pipeFds[1], splicePipeSize, spliceFMove|spliceFNonblock)
+		if err == syscall.EAGAIN {
+			time.Sleep(time.Millisecond)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("splice from JVM: %w", err)
+		}
+		if n == 0 {
+			logger.Info("finished streaming JVM response to file")
+			return nil
+		}
+
+		for n > 0 {
+			written, err := spliceSyscall(pipeFds[0], dstFd, n, spliceFMove)
+			if err != nil {
+				return fmt.Errorf("splice to file: %w", err)
+			}
+			n -= written
+		}
+	}
+}
+
+// spliceSyscall wraps the splice(2) syscall, moving up to n bytes from fdIn
+// to fdOut using the current file offset of each (off_in/off_out are left
+// nil, matching our callers which always splice from/to a pipe or a
+// seekable fd positioned where we want it).
+func spliceSyscall(fdIn, fdOut int, n int, flags int) (int, error) {
+	written, _, errno := syscall.Syscall6(syscall.SYS_SPLICE, uintptr(fdIn), 0, uintptr(fdOut), 0, uintptr(n), uintptr(flags))
+	if errno != 0 {
+		return 0, errno
+	}
+	return int(written), nil
+}
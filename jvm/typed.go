@@ -0,0 +1,237 @@
+package jvm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// Command is one of the dynamic attach commands the JVM understands.
+type Command string
+
+const (
+	CommandLoad            Command = "load"
+	CommandThreadDump      Command = "threaddump"
+	CommandDumpHeap        Command = "dumpheap"
+	CommandSetFlag         Command = "setflag"
+	CommandProperties      Command = "properties"
+	CommandJCmd            Command = "jcmd"
+	CommandInspectHeap     Command = "inspectheap"
+	CommandDataDump        Command = "datadump"
+	CommandPrintFlag       Command = "printflag"
+	CommandAgentProperties Command = "agentProperties"
+)
+
+// Result is implemented by every typed response JattachTyped can return;
+// the concrete type depends on the Command that produced it.
+type Result interface {
+	isResult()
+}
+
+// LoadResult is the response to a "load" command. The HotSpot attach
+// protocol collapses its own attach-status and the agent's return code into
+// a single integer for "load", so there is only one field to report.
+type LoadResult struct {
+	ReturnCode int
+}
+
+func (LoadResult) isResult() {}
+
+// ThreadDumpResult is the response to a "threaddump" command, broken out
+// into one entry per thread.
+type ThreadDumpResult struct {
+	Status  int
+	Threads []ThreadStack
+}
+
+func (ThreadDumpResult) isResult() {}
+
+// ThreadStack is a single thread's header line (name, daemon flag,
+// priority, state) and the stack frames printed beneath it.
+type ThreadStack struct {
+	Header string
+	Frames []string
+}
+
+// PropertiesResult is the response to a "properties" or "agentProperties"
+// command.
+type PropertiesResult struct {
+	Status     int
+	Properties map[string]string
+}
+
+func (PropertiesResult) isResult() {}
+
+// HeapHistogramResult is the response to an "inspectheap" command, one row
+// per class.
+type HeapHistogramResult struct {
+	Status int
+	Rows   []HeapHistogramRow
+}
+
+func (HeapHistogramResult) isResult() {}
+
+// HeapHistogramRow is a single class/instance-count/byte-size row from a
+// heap histogram.
+type HeapHistogramRow struct {
+	Class     string
+	Instances int64
+	Bytes     int64
+}
+
+// JCmdResult is the response to a "jcmd" or "datadump" command: the JVM
+// echoes the command it ran ahead of the command's own output.
+type JCmdResult struct {
+	Status  int
+	Command string
+	Body    string
+}
+
+func (JCmdResult) isResult() {}
+
+// FlagResult is the response to a "setflag" or "printflag" command.
+type FlagResult struct {
+	Status   int
+	Response string
+}
+
+func (FlagResult) isResult() {}
+
+// DumpHeapResult is the response to a "dumpheap" command. The heap itself
+// is written to the path passed in argv, not returned here; Status and
+// Message only cover the JVM's short attach acknowledgement.
+type DumpHeapResult struct {
+	Status  int
+	Message string
+}
+
+func (DumpHeapResult) isResult() {}
+
+// JattachTyped runs cmd against pid and parses the JVM's ad hoc text output
+// into a typed Result, instead of leaving every caller to re-parse the raw
+// bytes Jattach forwards on its channel.
+func JattachTyped(pid int, cmd Command, logger *slog.Logger, args ...string) (Result, error) {
+	parse, ok := resultParsers[cmd]
+	if !ok {
+		return nil, fmt.Errorf("jvm: no typed parser registered for command %q", cmd)
+	}
+
+	argv := append([]string{string(cmd)}, args...)
+
+	out := make(chan []byte)
+	var body bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		for chunk := range out {
+			body.Write(chunk)
+		}
+		close(done)
+	}()
+
+	status := Jattach(pid, argv, out, logger)
+	<-done
+
+	return parse(status, body.Bytes())
+}
+
+type resultParser func(status int, body []byte) (Result, error)
+
+var resultParsers = map[Command]resultParser{
+	CommandLoad:            parseLoadResult,
+	CommandThreadDump:      parseThreadDumpResult,
+	CommandDumpHeap:        parseDumpHeapResult,
+	CommandSetFlag:         parseFlagResult,
+	CommandProperties:      parsePropertiesResult,
+	CommandJCmd:            parseJCmdResult,
+	CommandInspectHeap:     parseHeapHistogramResult,
+	CommandDataDump:        parseJCmdResult,
+	CommandPrintFlag:       parseFlagResult,
+	CommandAgentProperties: parsePropertiesResult,
+}
+
+func parseLoadResult(status int, body []byte) (Result, error) {
+	return LoadResult{ReturnCode: status}, nil
+}
+
+func parseFlagResult(status int, body []byte) (Result, error) {
+	return FlagResult{Status: status, Response: strings.TrimSpace(string(body))}, nil
+}
+
+func parseDumpHeapResult(status int, body []byte) (Result, error) {
+	return DumpHeapResult{Status: status, Message: strings.TrimSpace(string(body))}, nil
+}
+
+// parsePropertiesResult turns "key=value" lines into a map, the format both
+// "properties" and "agentProperties" print.
+func parsePropertiesResult(status int, body []byte) (Result, error) {
+	props := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return PropertiesResult{Status: status, Properties: props}, scanner.Err()
+}
+
+// parseJCmdResult splits off the command echo jcmd/datadump print on their
+// first line from the body that follows it.
+func parseJCmdResult(status int, body []byte) (Result, error) {
+	cmdEcho, rest, _ := strings.Cut(string(body), "\n")
+	return JCmdResult{Status: status, Command: strings.TrimSpace(cmdEcho), Body: rest}, nil
+}
+
+// parseThreadDumpResult splits a HotSpot thread dump into its per-thread
+// sections. HotSpot introduces each thread with a quoted name followed by
+// its state and stack frames, and separates threads with a blank line.
+func parseThreadDumpResult(status int, body []byte) (Result, error) {
+	var threads []ThreadStack
+	var current *ThreadStack
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "\""):
+			threads = append(threads, ThreadStack{Header: line})
+			current = &threads[len(threads)-1]
+		case strings.TrimSpace(line) == "":
+			current = nil
+		case current != nil:
+			current.Frames = append(current.Frames, strings.TrimSpace(line))
+		}
+	}
+
+	return ThreadDumpResult{Status: status, Threads: threads}, scanner.Err()
+}
+
+// parseHeapHistogramResult parses the "jmap -histo"-style table inspectheap
+// prints: rank, instance count, byte size, and class name columns.
+func parseHeapHistogramResult(status int, body []byte) (Result, error) {
+	var rows []HeapHistogramRow
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+
+		instances, err1 := strconv.ParseInt(fields[1], 10, 64)
+		size, err2 := strconv.ParseInt(fields[2], 10, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		rows = append(rows, HeapHistogramRow{Class: fields[3], Instances: instances, Bytes: size})
+	}
+
+	return HeapHistogramResult{Status: status, Rows: rows}, scanner.Err()
+}
@@ -0,0 +1,224 @@
+package jvm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Golden fixtures under testdata/ are hand-captured samples of the raw text
+// HotSpot writes for each dynamic attach command. threaddump and jcmd have
+// one fixture per major LTS release (8, 11, 17, 21) because their output
+// format actually drifted across those releases (thread headers gained
+// cpu=/elapsed= fields in 9+, VM.version's banner text changes every
+// release); the other commands' formats have been stable since JDK 8, so a
+// single fixture covers all four.
+func goldenBody(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading golden file %s: %v", name, err)
+	}
+	return body
+}
+
+func TestParseLoadResult(t *testing.T) {
+	body := goldenBody(t, "load.txt")
+
+	result, err := parseLoadResult(0, body)
+	if err != nil {
+		t.Fatalf("parseLoadResult: %v", err)
+	}
+	load, ok := result.(LoadResult)
+	if !ok {
+		t.Fatalf("got %T, want LoadResult", result)
+	}
+	if load.ReturnCode != 0 {
+		t.Errorf("ReturnCode = %d, want 0", load.ReturnCode)
+	}
+}
+
+func TestParseDumpHeapResult(t *testing.T) {
+	body := goldenBody(t, "dumpheap.txt")
+
+	result, err := parseDumpHeapResult(0, body)
+	if err != nil {
+		t.Fatalf("parseDumpHeapResult: %v", err)
+	}
+	dump := result.(DumpHeapResult)
+	if dump.Status != 0 {
+		t.Errorf("Status = %d, want 0", dump.Status)
+	}
+	if want := "Dumping heap to /tmp/heap.bin ...\nHeap dump file created [48213764 bytes in 1.284 secs]"; dump.Message != want {
+		t.Errorf("Message = %q, want %q", dump.Message, want)
+	}
+}
+
+func TestParseFlagResult(t *testing.T) {
+	tests := []struct {
+		cmd    Command
+		golden string
+		want   string
+	}{
+		{CommandSetFlag, "setflag.txt", "-XX:+PrintGCDetails is set to true"},
+		{CommandPrintFlag, "printflag.txt", "-XX:MaxHeapSize=2147483648"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.cmd), func(t *testing.T) {
+			body := goldenBody(t, tt.golden)
+
+			result, err := parseFlagResult(0, body)
+			if err != nil {
+				t.Fatalf("parseFlagResult: %v", err)
+			}
+			flag := result.(FlagResult)
+			if flag.Response != tt.want {
+				t.Errorf("Response = %q, want %q", flag.Response, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePropertiesResult(t *testing.T) {
+	tests := []struct {
+		cmd    Command
+		golden string
+		want   map[string]string
+	}{
+		{
+			CommandProperties, "properties.txt",
+			map[string]string{
+				"java.vm.version":       "25.362-b09",
+				"java.vm.vendor":        "Eclipse Adoptium",
+				"sun.boot.library.path": "/usr/lib/jvm/temurin-8/lib",
+				"java.class.path":       "/opt/app/app.jar",
+				"user.timezone":         "UTC",
+			},
+		},
+		{
+			CommandAgentProperties, "agentproperties.txt",
+			map[string]string{
+				"sun.jvm.args":     "-Xmx2g -javaagent:/opt/agent/agent.jar",
+				"sun.jvm.flags":    "",
+				"sun.java.command": "com.example.App",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.cmd), func(t *testing.T) {
+			body := goldenBody(t, tt.golden)
+
+			result, err := parsePropertiesResult(0, body)
+			if err != nil {
+				t.Fatalf("parsePropertiesResult: %v", err)
+			}
+			props := result.(PropertiesResult)
+			if len(props.Properties) != len(tt.want) {
+				t.Fatalf("got %d properties, want %d: %v", len(props.Properties), len(tt.want), props.Properties)
+			}
+			for k, v := range tt.want {
+				if props.Properties[k] != v {
+					t.Errorf("Properties[%q] = %q, want %q", k, props.Properties[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestParseJCmdResult(t *testing.T) {
+	versions := []string{"jdk8", "jdk11", "jdk17", "jdk21"}
+	wantVersion := map[string]string{
+		"jdk8":  "8.0.362",
+		"jdk11": "11.0.21",
+		"jdk17": "17.0.9",
+		"jdk21": "21.0.1",
+	}
+
+	for _, v := range versions {
+		t.Run(v, func(t *testing.T) {
+			body := goldenBody(t, "jcmd_"+v+".txt")
+
+			result, err := parseJCmdResult(0, body)
+			if err != nil {
+				t.Fatalf("parseJCmdResult: %v", err)
+			}
+			jcmd := result.(JCmdResult)
+			if jcmd.Command != "VM.version" {
+				t.Errorf("Command = %q, want %q", jcmd.Command, "VM.version")
+			}
+			want := "JDK " + wantVersion[v]
+			if !strings.Contains(jcmd.Body, want) {
+				t.Errorf("Body %q does not contain %q", jcmd.Body, want)
+			}
+		})
+	}
+}
+
+func TestParseJCmdResultDataDump(t *testing.T) {
+	body := goldenBody(t, "datadump.txt")
+
+	result, err := parseJCmdResult(0, body)
+	if err != nil {
+		t.Fatalf("parseJCmdResult: %v", err)
+	}
+	jcmd := result.(JCmdResult)
+	if jcmd.Command != "VM.flags" {
+		t.Errorf("Command = %q, want %q", jcmd.Command, "VM.flags")
+	}
+	if !strings.Contains(jcmd.Body, "-XX:MaxHeapSize=4294967296") {
+		t.Errorf("Body %q missing expected flag", jcmd.Body)
+	}
+}
+
+func TestParseThreadDumpResult(t *testing.T) {
+	tests := []struct {
+		version     string
+		wantThreads int
+		wantHeader  string
+	}{
+		{"jdk8", 2, `"main" #1 prio=5 os_prio=0 tid=0x00007f0a2400a800 nid=0x1a runnable [0x00007f0a2effc000]`},
+		{"jdk11", 2, `"main" #1 prio=5 os_prio=0 cpu=15.62ms elapsed=4.31s tid=0x00007f7cd400a800 nid=0x1a runnable  [0x00007f7cdeffc000]`},
+		{"jdk17", 2, `"main" #1 prio=5 os_prio=0 cpu=18.75ms elapsed=6.02s tid=0x00007fb478009800 nid=0x1a runnable  [0x00007fb482ffb000]`},
+		{"jdk21", 2, `"main" #1 prio=5 os_prio=0 cpu=22.14ms elapsed=8.90s tid=0x00007f2f5000a800 nid=0x1a runnable  [0x00007f2f5affe000]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			body := goldenBody(t, "threaddump_"+tt.version+".txt")
+
+			result, err := parseThreadDumpResult(0, body)
+			if err != nil {
+				t.Fatalf("parseThreadDumpResult: %v", err)
+			}
+			dump := result.(ThreadDumpResult)
+			if len(dump.Threads) != tt.wantThreads {
+				t.Fatalf("got %d threads, want %d", len(dump.Threads), tt.wantThreads)
+			}
+			if dump.Threads[0].Header != tt.wantHeader {
+				t.Errorf("Threads[0].Header = %q, want %q", dump.Threads[0].Header, tt.wantHeader)
+			}
+			if len(dump.Threads[0].Frames) == 0 {
+				t.Errorf("Threads[0].Frames is empty, want at least one frame")
+			}
+		})
+	}
+}
+
+func TestParseHeapHistogramResult(t *testing.T) {
+	body := goldenBody(t, "inspectheap.txt")
+
+	result, err := parseHeapHistogramResult(0, body)
+	if err != nil {
+		t.Fatalf("parseHeapHistogramResult: %v", err)
+	}
+	histo := result.(HeapHistogramResult)
+	if len(histo.Rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(histo.Rows))
+	}
+	if got := histo.Rows[0]; got.Class != "[B" || got.Instances != 28312 || got.Bytes != 2718552 {
+		t.Errorf("Rows[0] = %+v, want {Class:[B Instances:28312 Bytes:2718552}", got)
+	}
+}